@@ -0,0 +1,65 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.swagger;
+
+import io.swagger.models.Swagger;
+import java.util.LinkedHashMap;
+import java.util.Map;
+import lombok.Getter;
+import lombok.Setter;
+import org.gradle.api.tasks.Input;
+
+/**
+ * Enable API Gateway request validation for all methods of an API.
+ *
+ * <p>This declares the standard set of request validators and selects one as the default for the
+ * whole API, so that API Gateway rejects requests that don't match the generated Swagger model
+ * (e.g. missing required parameters) before they reach the Lambda function.
+ *
+ * <p>See the documentation for more details:
+ * http://docs.aws.amazon.com/apigateway/latest/developerguide/api-gateway-swagger-extensions-request-validators.html
+ *
+ * <p>TODO Move everything in com.amazonaws.blox.swagger to a separate project
+ */
+@Getter
+@Setter
+public class ApiGatewayRequestValidatorFilter implements SwaggerFilter {
+  /** The name of the validator to apply to all methods, one of: all, body-only, params-only */
+  @Input private String defaultValidator = "all";
+
+  public Map<String, Object> validators() {
+    Map<String, Object> validators = new LinkedHashMap<>();
+
+    validators.put("all", validator(true, true));
+    validators.put("body-only", validator(true, false));
+    validators.put("params-only", validator(false, true));
+
+    return validators;
+  }
+
+  @Override
+  public void apply(Swagger swagger) {
+    swagger.setVendorExtension("x-amazon-apigateway-request-validators", validators());
+    swagger.setVendorExtension("x-amazon-apigateway-request-validator", defaultValidator);
+  }
+
+  private Map<String, Boolean> validator(boolean body, boolean parameters) {
+    Map<String, Boolean> map = new LinkedHashMap<>();
+    map.put("validateRequestBody", body);
+    map.put("validateRequestParameters", parameters);
+
+    return map;
+  }
+}
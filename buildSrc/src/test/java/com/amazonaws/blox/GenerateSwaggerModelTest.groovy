@@ -1,5 +1,6 @@
 package com.amazonaws.blox
 
+import com.amazonaws.blox.swagger.ApiGatewayRequestValidatorFilter
 import com.amazonaws.blox.tasks.GenerateSwaggerModel
 import com.fasterxml.jackson.databind.JsonNode
 import com.fasterxml.jackson.databind.ObjectMapper
@@ -9,6 +10,7 @@ import org.junit.Before
 import org.junit.Test
 
 import static org.junit.Assert.assertEquals
+import static org.junit.Assert.assertTrue
 
 class GenerateSwaggerModelTest {
     private File swaggerFile = new File("build/tmp/swagger.yml")
@@ -43,6 +45,31 @@ class GenerateSwaggerModelTest {
                 .asText())
     }
 
+    @Test
+    void appliesDefaultRequestValidatorToWholeApi() throws Exception {
+        GenerateSwaggerModel task = ProjectBuilder.builder().build().task("swagger", type: GenerateSwaggerModel)
+
+        task.scanClasspath = this.classpath
+        task.apiClasses = ["com.amazonaws.blox.TestController"]
+        task.swaggerFile = this.swaggerFile
+        task.filters.add(new ApiGatewayRequestValidatorFilter())
+
+        task.execute()
+
+        JsonNode swagger = readSwaggerFile()
+
+        assertEquals("all", swagger.get("x-amazon-apigateway-request-validator").asText())
+        assertEquals(["all", "body-only", "params-only"], swagger
+                .get("x-amazon-apigateway-request-validators")
+                .fieldNames()
+                .collect())
+        assertTrue(swagger
+                .get("x-amazon-apigateway-request-validators")
+                .get("all")
+                .get("validateRequestParameters")
+                .asBoolean())
+    }
+
     private JsonNode readSwaggerFile() {
         new ObjectMapper(new YAMLFactory()).readTree(this.swaggerFile)
     }
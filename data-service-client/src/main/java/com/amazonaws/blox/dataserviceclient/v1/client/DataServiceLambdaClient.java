@@ -15,10 +15,70 @@
 package com.amazonaws.blox.dataserviceclient.v1.client;
 
 import com.amazonaws.blox.dataservicemodel.v1.client.DataService;
+import com.amazonaws.blox.dataservicemodel.v1.model.AcquireDeploymentLeaseRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.AcquireDeploymentLeaseResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.CordonInstanceRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.CordonInstanceResponse;
 import com.amazonaws.blox.dataservicemodel.v1.model.CreateEnvironmentRequest;
 import com.amazonaws.blox.dataservicemodel.v1.model.CreateEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.CreateEnvironmentTemplateRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.CreateEnvironmentTemplateResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.CutoverDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.CutoverDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DeleteEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DeleteEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentReportRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentReportResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentTimelineRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentTimelineResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeEnvironmentTemplateRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeEnvironmentTemplateResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeTaskRunRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeTaskRunResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.FreezeDeploymentsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.FreezeDeploymentsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.InstantiateEnvironmentTemplateRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.InstantiateEnvironmentTemplateResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListDeploymentFreezesRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListDeploymentFreezesResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListDeploymentsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListDeploymentsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListEnvironmentEventsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListEnvironmentEventsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListEnvironmentsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListEnvironmentsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListInstanceCordonsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListInstanceCordonsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListTaskRunsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListTaskRunsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.PromoteDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.PromoteDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ReconcileEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ReconcileEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ReleaseDeploymentLeaseRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ReleaseDeploymentLeaseResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ResumeEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ResumeEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.RollbackDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.RollbackDeploymentResponse;
 import com.amazonaws.blox.dataservicemodel.v1.model.StartDeploymentRequest;
 import com.amazonaws.blox.dataservicemodel.v1.model.StartDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.StartTaskRunRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.StartTaskRunResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.StopDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.StopDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.SuspendEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.SuspendEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.UncordonInstanceRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.UncordonInstanceResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.UnfreezeDeploymentsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.UnfreezeDeploymentsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.UpdateEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.UpdateEnvironmentResponse;
 import lombok.AllArgsConstructor;
 
 /**
@@ -33,8 +93,168 @@ public class DataServiceLambdaClient implements DataService {
     throw new UnsupportedOperationException();
   }
 
+  @Override
+  public DescribeEnvironmentResponse describeEnvironment(final DescribeEnvironmentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ListEnvironmentsResponse listEnvironments(final ListEnvironmentsRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public UpdateEnvironmentResponse updateEnvironment(final UpdateEnvironmentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public DeleteEnvironmentResponse deleteEnvironment(final DeleteEnvironmentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public CreateEnvironmentTemplateResponse createEnvironmentTemplate(
+      final CreateEnvironmentTemplateRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public DescribeEnvironmentTemplateResponse describeEnvironmentTemplate(
+      final DescribeEnvironmentTemplateRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public InstantiateEnvironmentTemplateResponse instantiateEnvironmentTemplate(
+      final InstantiateEnvironmentTemplateRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public SuspendEnvironmentResponse suspendEnvironment(final SuspendEnvironmentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ResumeEnvironmentResponse resumeEnvironment(final ResumeEnvironmentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ReconcileEnvironmentResponse reconcileEnvironment(
+      final ReconcileEnvironmentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ListEnvironmentEventsResponse listEnvironmentEvents(
+      final ListEnvironmentEventsRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ListTaskRunsResponse listTaskRuns(final ListTaskRunsRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public StartTaskRunResponse startTaskRun(final StartTaskRunRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public DescribeTaskRunResponse describeTaskRun(final DescribeTaskRunRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
   @Override
   public StartDeploymentResponse startDeployment(final StartDeploymentRequest request) {
     throw new UnsupportedOperationException();
   }
+
+  @Override
+  public RollbackDeploymentResponse rollbackDeployment(final RollbackDeploymentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ListDeploymentsResponse listDeployments(final ListDeploymentsRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public DescribeDeploymentResponse describeDeployment(final DescribeDeploymentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public StopDeploymentResponse stopDeployment(final StopDeploymentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public CutoverDeploymentResponse cutoverDeployment(final CutoverDeploymentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public PromoteDeploymentResponse promoteDeployment(final PromoteDeploymentRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public DescribeDeploymentReportResponse describeDeploymentReport(
+      final DescribeDeploymentReportRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public DescribeDeploymentTimelineResponse describeDeploymentTimeline(
+      final DescribeDeploymentTimelineRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public AcquireDeploymentLeaseResponse acquireDeploymentLease(
+      final AcquireDeploymentLeaseRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ReleaseDeploymentLeaseResponse releaseDeploymentLease(
+      final ReleaseDeploymentLeaseRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public FreezeDeploymentsResponse freezeDeployments(final FreezeDeploymentsRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public UnfreezeDeploymentsResponse unfreezeDeployments(final UnfreezeDeploymentsRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ListDeploymentFreezesResponse listDeploymentFreezes(
+      final ListDeploymentFreezesRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public CordonInstanceResponse cordonInstance(final CordonInstanceRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public UncordonInstanceResponse uncordonInstance(final UncordonInstanceRequest request) {
+    throw new UnsupportedOperationException();
+  }
+
+  @Override
+  public ListInstanceCordonsResponse listInstanceCordons(final ListInstanceCordonsRequest request) {
+    throw new UnsupportedOperationException();
+  }
 }
@@ -14,16 +14,85 @@
  */
 package com.amazonaws.blox.dataservicemodel.v1.client;
 
+import com.amazonaws.blox.dataservicemodel.v1.exception.DeploymentFreezeActiveException;
+import com.amazonaws.blox.dataservicemodel.v1.exception.DeploymentInProgressException;
+import com.amazonaws.blox.dataservicemodel.v1.exception.DeploymentLeaseHeldException;
+import com.amazonaws.blox.dataservicemodel.v1.exception.DeploymentNotFoundException;
+import com.amazonaws.blox.dataservicemodel.v1.exception.DeploymentNotReadyException;
 import com.amazonaws.blox.dataservicemodel.v1.exception.EnvironmentExistsException;
 import com.amazonaws.blox.dataservicemodel.v1.exception.EnvironmentNotFoundException;
+import com.amazonaws.blox.dataservicemodel.v1.exception.EnvironmentSuspendedException;
+import com.amazonaws.blox.dataservicemodel.v1.exception.EnvironmentTemplateExistsException;
+import com.amazonaws.blox.dataservicemodel.v1.exception.EnvironmentTemplateNotFoundException;
 import com.amazonaws.blox.dataservicemodel.v1.exception.EnvironmentVersionNotFoundException;
 import com.amazonaws.blox.dataservicemodel.v1.exception.EnvironmentVersionOutdatedException;
 import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
 import com.amazonaws.blox.dataservicemodel.v1.exception.ServiceException;
+import com.amazonaws.blox.dataservicemodel.v1.exception.TaskRunNotFoundException;
+import com.amazonaws.blox.dataservicemodel.v1.model.AcquireDeploymentLeaseRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.AcquireDeploymentLeaseResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.CordonInstanceRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.CordonInstanceResponse;
 import com.amazonaws.blox.dataservicemodel.v1.model.CreateEnvironmentRequest;
 import com.amazonaws.blox.dataservicemodel.v1.model.CreateEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.CreateEnvironmentTemplateRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.CreateEnvironmentTemplateResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.CutoverDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.CutoverDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DeleteEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DeleteEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentReportRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentReportResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentTimelineRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeDeploymentTimelineResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeEnvironmentTemplateRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeEnvironmentTemplateResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeTaskRunRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.DescribeTaskRunResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.FreezeDeploymentsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.FreezeDeploymentsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.InstantiateEnvironmentTemplateRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.InstantiateEnvironmentTemplateResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListDeploymentFreezesRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListDeploymentFreezesResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListDeploymentsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListDeploymentsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListEnvironmentEventsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListEnvironmentEventsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListEnvironmentsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListEnvironmentsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListInstanceCordonsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListInstanceCordonsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListTaskRunsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ListTaskRunsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.PromoteDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.PromoteDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ReconcileEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ReconcileEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ReleaseDeploymentLeaseRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ReleaseDeploymentLeaseResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.ResumeEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.ResumeEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.RollbackDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.RollbackDeploymentResponse;
 import com.amazonaws.blox.dataservicemodel.v1.model.StartDeploymentRequest;
 import com.amazonaws.blox.dataservicemodel.v1.model.StartDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.StartTaskRunRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.StartTaskRunResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.StopDeploymentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.StopDeploymentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.SuspendEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.SuspendEnvironmentResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.UncordonInstanceRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.UncordonInstanceResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.UnfreezeDeploymentsRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.UnfreezeDeploymentsResponse;
+import com.amazonaws.blox.dataservicemodel.v1.model.UpdateEnvironmentRequest;
+import com.amazonaws.blox.dataservicemodel.v1.model.UpdateEnvironmentResponse;
 
 public interface DataService {
 
@@ -31,8 +100,215 @@ public interface DataService {
   CreateEnvironmentResponse createEnvironment(CreateEnvironmentRequest request)
       throws EnvironmentExistsException, InvalidParameterException, ServiceException;
 
+  /** Returns the current version of an environment record, including its conditions. */
+  DescribeEnvironmentResponse describeEnvironment(DescribeEnvironmentRequest request)
+      throws EnvironmentNotFoundException, InvalidParameterException, ServiceException;
+
+  /** Lists environments, optionally filtered by a label selector. */
+  ListEnvironmentsResponse listEnvironments(ListEnvironmentsRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /**
+   * Creates a new version of an environment record, and starts a deployment of it. With
+   * skipDeployment, the new version is only deployed by a later StartDeployment call.
+   */
+  UpdateEnvironmentResponse updateEnvironment(UpdateEnvironmentRequest request)
+      throws EnvironmentNotFoundException, EnvironmentSuspendedException,
+          DeploymentFreezeActiveException, DeploymentLeaseHeldException, InvalidParameterException,
+          ServiceException;
+
+  /**
+   * Marks an environment to be deleted. Unless force is set, the tasks started by its deployments
+   * are stopped before the record is removed. Environments with a user-created deployment in
+   * progress can't be deleted until it is stopped.
+   */
+  DeleteEnvironmentResponse deleteEnvironment(DeleteEnvironmentRequest request)
+      throws EnvironmentNotFoundException, DeploymentInProgressException,
+          InvalidParameterException, ServiceException;
+
+  /**
+   * Stores a named environment template for InstantiateEnvironmentTemplate to create environments
+   * from.
+   */
+  CreateEnvironmentTemplateResponse createEnvironmentTemplate(
+      CreateEnvironmentTemplateRequest request)
+      throws EnvironmentTemplateExistsException, InvalidParameterException, ServiceException;
+
+  /** Returns a stored environment template. */
+  DescribeEnvironmentTemplateResponse describeEnvironmentTemplate(
+      DescribeEnvironmentTemplateRequest request)
+      throws EnvironmentTemplateNotFoundException, InvalidParameterException, ServiceException;
+
+  /**
+   * Creates an environment from a template, replacing each of its placeholders with the given
+   * argument, as if CreateEnvironment had been called with the result. Missing arguments and
+   * arguments for undeclared parameters are rejected.
+   */
+  InstantiateEnvironmentTemplateResponse instantiateEnvironmentTemplate(
+      InstantiateEnvironmentTemplateRequest request)
+      throws EnvironmentTemplateNotFoundException, EnvironmentExistsException,
+          InvalidParameterException, ServiceException;
+
+  /**
+   * Suspends an environment. While suspended, the scheduler takes no action on the environment's
+   * tasks and new deployments fail with an EnvironmentSuspendedException, but its state is kept.
+   * Suspension is tracked separately from the environment's status, which doesn't change.
+   */
+  SuspendEnvironmentResponse suspendEnvironment(SuspendEnvironmentRequest request)
+      throws EnvironmentNotFoundException, InvalidParameterException, ServiceException;
+
+  /**
+   * Resumes a suspended environment, so that the scheduler converges it again according to its
+   * unchanged status.
+   */
+  ResumeEnvironmentResponse resumeEnvironment(ResumeEnvironmentRequest request)
+      throws EnvironmentNotFoundException, InvalidParameterException, ServiceException;
+
+  /**
+   * Requests an immediate reconciliation of an environment, instead of waiting for the next
+   * periodic one. Reconciliation starts missing tasks and stops extra ones.
+   */
+  ReconcileEnvironmentResponse reconcileEnvironment(ReconcileEnvironmentRequest request)
+      throws EnvironmentNotFoundException, EnvironmentSuspendedException, InvalidParameterException,
+          ServiceException;
+
+  /** Lists the lifecycle events recorded for an environment, latest first. */
+  ListEnvironmentEventsResponse listEnvironmentEvents(ListEnvironmentEventsRequest request)
+      throws EnvironmentNotFoundException, InvalidParameterException, ServiceException;
+
+  /** Lists the runs of a SCHEDULED environment's task, latest first. */
+  ListTaskRunsResponse listTaskRuns(ListTaskRunsRequest request)
+      throws EnvironmentNotFoundException, InvalidParameterException, ServiceException;
+
+  /**
+   * Creates a one-off task run, which asynchronously runs a task definition once on a single
+   * instance chosen from the given instance group.
+   */
+  StartTaskRunResponse startTaskRun(StartTaskRunRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /** Returns the status of a task run. */
+  DescribeTaskRunResponse describeTaskRun(DescribeTaskRunRequest request)
+      throws TaskRunNotFoundException, InvalidParameterException, ServiceException;
+
   /** Creates a deployment record which asynchronously starts a deployment. */
   StartDeploymentResponse startDeployment(StartDeploymentRequest request)
       throws EnvironmentNotFoundException, EnvironmentVersionNotFoundException,
-          EnvironmentVersionOutdatedException, InvalidParameterException, ServiceException;
+          EnvironmentVersionOutdatedException, EnvironmentSuspendedException,
+          DeploymentFreezeActiveException, DeploymentLeaseHeldException, InvalidParameterException,
+          ServiceException;
+
+  /**
+   * Creates a deployment record that redeploys an earlier environment version, by default the one
+   * deployed by the previous successful deployment. Fails with a DeploymentNotFoundException if the
+   * version was never deployed successfully.
+   *
+   * <p>The environment's in-progress deployment, including a canary awaiting promotion, is stopped
+   * and its pending deployments are canceled, so the rollback is started next and replaces the
+   * tasks the stopped deployment started.
+   */
+  RollbackDeploymentResponse rollbackDeployment(RollbackDeploymentRequest request)
+      throws EnvironmentNotFoundException, EnvironmentVersionNotFoundException,
+          EnvironmentSuspendedException, DeploymentNotFoundException,
+          DeploymentFreezeActiveException, DeploymentLeaseHeldException, InvalidParameterException,
+          ServiceException;
+
+  /**
+   * Lists an environment's deployments, latest first. The PENDING deployments are the environment's
+   * queue: they are started one at a time in the order they were created, once no deployment is in
+   * progress.
+   */
+  ListDeploymentsResponse listDeployments(ListDeploymentsRequest request)
+      throws EnvironmentNotFoundException, InvalidParameterException, ServiceException;
+
+  /** Returns a deployment, including its full status history. */
+  DescribeDeploymentResponse describeDeployment(DescribeDeploymentRequest request)
+      throws EnvironmentNotFoundException, DeploymentNotFoundException, InvalidParameterException,
+          ServiceException;
+
+  /**
+   * Marks a pending or in-progress deployment to be stopped. A pending deployment is removed from
+   * the environment's queue and canceled. An in-progress deployment stops starting new tasks and is
+   * moved to a terminal state; tasks it already started are only stopped on request, except for the
+   * new tasks of a blue/green deployment that hasn't been cut over, which are always stopped.
+   */
+  StopDeploymentResponse stopDeployment(StopDeploymentRequest request)
+      throws EnvironmentNotFoundException, DeploymentNotFoundException, InvalidParameterException,
+          ServiceException;
+
+  /**
+   * Makes the new tasks of a BLUE_GREEN deployment live and stops the old ones. Fails with a
+   * DeploymentNotReadyException unless the deployment is AWAITING_CUTOVER.
+   */
+  CutoverDeploymentResponse cutoverDeployment(CutoverDeploymentRequest request)
+      throws EnvironmentNotFoundException, DeploymentNotFoundException, DeploymentNotReadyException,
+          DeploymentLeaseHeldException, InvalidParameterException, ServiceException;
+
+  /**
+   * Rolls a canary deployment out to all of the environment's instances, moving it from
+   * AWAITING_PROMOTION back to IN_PROGRESS. Fails with a DeploymentNotReadyException until the
+   * canary's observation period has passed.
+   */
+  PromoteDeploymentResponse promoteDeployment(PromoteDeploymentRequest request)
+      throws EnvironmentNotFoundException, DeploymentNotFoundException, DeploymentNotReadyException,
+          DeploymentLeaseHeldException, InvalidParameterException, ServiceException;
+
+  /**
+   * Summarizes the deployments created in a time range across all environments, e.g. for a weekly
+   * release report.
+   */
+  DescribeDeploymentReportResponse describeDeploymentReport(DescribeDeploymentReportRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /**
+   * Lists the deployments that overlapped a time range, grouped by cluster, e.g. to chart how
+   * rollouts overlapped for a change-management review.
+   */
+  DescribeDeploymentTimelineResponse describeDeploymentTimeline(
+      DescribeDeploymentTimelineRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /**
+   * Reserves the exclusive right to start deployments in an environment for a bounded time. While
+   * the lease is held, StartDeployment and RollbackDeployment requests that don't present its lease
+   * ID fail with a DeploymentLeaseHeldException. Leases last at most
+   * AcquireDeploymentLeaseRequest.MAX_DURATION.
+   */
+  AcquireDeploymentLeaseResponse acquireDeploymentLease(AcquireDeploymentLeaseRequest request)
+      throws EnvironmentNotFoundException, DeploymentLeaseHeldException, InvalidParameterException,
+          ServiceException;
+
+  /** Releases a lease acquired with AcquireDeploymentLease before it expires. */
+  ReleaseDeploymentLeaseResponse releaseDeploymentLease(ReleaseDeploymentLeaseRequest request)
+      throws EnvironmentNotFoundException, InvalidParameterException, ServiceException;
+
+  /**
+   * Freezes deployments globally or in a single cluster. While a freeze is active, StartDeployment
+   * requests that it applies to fail with a DeploymentFreezeActiveException.
+   */
+  FreezeDeploymentsResponse freezeDeployments(FreezeDeploymentsRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /** Lifts a deployment freeze created by FreezeDeployments. */
+  UnfreezeDeploymentsResponse unfreezeDeployments(UnfreezeDeploymentsRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /** Lists the active deployment freezes. */
+  ListDeploymentFreezesResponse listDeploymentFreezes(ListDeploymentFreezesRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /**
+   * Cordons a container instance. Cordoned instances are excluded from the placements of all
+   * environments, but their running tasks and their ECS state are left alone.
+   */
+  CordonInstanceResponse cordonInstance(CordonInstanceRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /** Lifts a cordon created by CordonInstance. */
+  UncordonInstanceResponse uncordonInstance(UncordonInstanceRequest request)
+      throws InvalidParameterException, ServiceException;
+
+  /** Lists the cordoned container instances. */
+  ListInstanceCordonsResponse listInstanceCordons(ListInstanceCordonsRequest request)
+      throws InvalidParameterException, ServiceException;
 }
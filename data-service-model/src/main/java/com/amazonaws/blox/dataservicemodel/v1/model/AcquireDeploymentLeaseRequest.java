@@ -0,0 +1,53 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.time.Duration;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+public class AcquireDeploymentLeaseRequest {
+
+  /** The longest a lease can be held for. */
+  public static final Duration MAX_DURATION = Duration.ofHours(12);
+
+  private final String environmentName;
+
+  private final String holder;
+
+  /**
+   * How long the lease is held for if it isn't released. Must be positive and at most MAX_DURATION.
+   */
+  private final Duration duration;
+
+  @Builder
+  private AcquireDeploymentLeaseRequest(
+      @NonNull final String environmentName,
+      @NonNull final String holder,
+      @NonNull final Duration duration)
+      throws InvalidParameterException {
+    if (duration.isNegative() || duration.isZero() || duration.compareTo(MAX_DURATION) > 0) {
+      throw new InvalidParameterException(
+          "Lease duration must be positive and at most " + MAX_DURATION + ", was " + duration);
+    }
+
+    this.environmentName = environmentName;
+    this.holder = holder;
+    this.duration = duration;
+  }
+}
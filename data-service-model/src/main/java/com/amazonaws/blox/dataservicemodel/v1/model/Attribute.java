@@ -25,4 +25,7 @@ public class Attribute {
   @NonNull private String name;
 
   @NonNull private String value;
+
+  /** How value is compared with the instance's value. Defaults to EQUALS. */
+  private AttributeOperator operator;
 }
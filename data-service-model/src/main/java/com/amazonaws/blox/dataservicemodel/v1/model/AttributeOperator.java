@@ -0,0 +1,25 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+/** How an Attribute's value is compared with an instance's value for the same attribute name. */
+public enum AttributeOperator {
+  /** The instance's value is exactly the given value. */
+  EQUALS,
+  /** The instance doesn't have the attribute, or its value is not the given value. */
+  NOT_EQUALS,
+  /** The instance's value matches the given value as a pattern, e.g. {@code c4.*}. */
+  MATCHES
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.time.Duration;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/**
+ * Starts a deployment on a subset of the environment's instances first. Exactly one of
+ * instanceCount and instancePercent must be set.
+ */
+@Value
+public class CanaryConfiguration {
+
+  /** The number of instances to deploy to first, at least 1. */
+  private final Integer instanceCount;
+
+  /** The percentage of instances to deploy to first, from 1 to 99, rounded up. */
+  private final Integer instancePercent;
+
+  /**
+   * How long the deployment is held on the canary instances before it can be promoted with
+   * PromoteDeployment.
+   */
+  private final Duration observationPeriod;
+
+  @Builder
+  private CanaryConfiguration(
+      final Integer instanceCount,
+      final Integer instancePercent,
+      @NonNull final Duration observationPeriod)
+      throws InvalidParameterException {
+    if ((instanceCount == null) == (instancePercent == null)) {
+      throw new InvalidParameterException(
+          "Exactly one of instanceCount and instancePercent must be set");
+    }
+    if (instanceCount != null && instanceCount < 1) {
+      throw new InvalidParameterException("instanceCount must be at least 1");
+    }
+    if (instancePercent != null && (instancePercent < 1 || instancePercent > 99)) {
+      throw new InvalidParameterException("instancePercent must be from 1 to 99");
+    }
+    if (observationPeriod.isNegative()) {
+      throw new InvalidParameterException("observationPeriod must not be negative");
+    }
+
+    this.instanceCount = instanceCount;
+    this.instancePercent = instancePercent;
+    this.observationPeriod = observationPeriod;
+  }
+}
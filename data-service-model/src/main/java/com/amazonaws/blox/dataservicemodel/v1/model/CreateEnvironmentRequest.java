@@ -14,19 +14,147 @@
  */
 package com.amazonaws.blox.dataservicemodel.v1.model;
 
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.time.Duration;
+import java.util.List;
+import java.util.Map;
 import lombok.Builder;
 import lombok.NonNull;
 import lombok.Value;
 
 @Value
-@Builder
 public class CreateEnvironmentRequest {
 
-  @NonNull private String name;
+  private String name;
 
-  @NonNull private String taskDefinition;
+  /**
+   * A task definition reference: a task definition ARN, "family:latest" for the latest ACTIVE
+   * revision of a family, or "ssm:parameter-name" for an SSM parameter containing a task definition
+   * ARN. References are resolved to a revision when a deployment is created.
+   */
+  private String taskDefinition;
 
-  @NonNull private String roleArn;
+  private String roleArn;
 
-  @NonNull private InstanceGroup instanceGroup;
+  private InstanceGroup instanceGroup;
+
+  /**
+   * Names of environments that must have completed their latest deployment before a deployment of
+   * this environment is started. If deployments of both are pending, this environment's deployment
+   * waits for the other's to complete. Dependencies that don't exist or form a cycle are rejected.
+   */
+  private List<String> dependsOn;
+
+  /** The type of scheduler that places the environment's tasks. Defaults to DAEMON. */
+  private EnvironmentType environmentType;
+
+  /**
+   * The number of tasks to run. Required for REPLICA environments, and not allowed for DAEMON
+   * environments, which run one task per instance.
+   */
+  private Integer desiredCount;
+
+  /** Where the environment's tasks run. Defaults to EC2. */
+  private LaunchType launchType;
+
+  /** Required for FARGATE environments, and not allowed for EC2 environments. */
+  private NetworkConfiguration networkConfiguration;
+
+  /**
+   * A cron expression for when a SCHEDULED environment's task is run, e.g. "0 3 * * ? *". Required
+   * for SCHEDULED environments, and not allowed for other types.
+   */
+  private String schedule;
+
+  /**
+   * Whether each scheduled run starts the task on every instance in the instance group, rather than
+   * on a single one. Only allowed for SCHEDULED environments, defaults to false.
+   */
+  private Boolean runOnAllInstances;
+
+  /**
+   * Environment variables set on the environment's tasks, as literal values or references to SSM
+   * parameters or Secrets Manager secrets that ECS resolves when each task starts.
+   */
+  private List<EnvironmentVariable> environmentVariables;
+
+  /**
+   * Whether to start a deployment of the environment's current version when a referenced SSM
+   * parameter or secret changes version. Defaults to false.
+   */
+  private Boolean redeployOnReferenceChange;
+
+  /** How deployments replace the environment's tasks. Defaults to ROLLING. */
+  private DeploymentStrategy deploymentStrategy;
+
+  /**
+   * If set, tasks older than this are gradually replaced with new ones, without exceeding the
+   * environment's deployment limits on unavailable tasks. Each replacement is recorded as a
+   * system-initiated deployment.
+   */
+  private Duration maxTaskAge;
+
+  /**
+   * Names of environments whose tasks must not run on the same instance as this environment's.
+   * Environments that don't exist are rejected. The constraint applies in both directions, whether
+   * or not the other environment lists this one: instances running a task of either environment are
+   * skipped when placing the other's tasks.
+   */
+  private List<String> antiAffinity;
+
+  /**
+   * Task definition references deployed together with taskDefinition as one task group, e.g. a log
+   * shipper and a metrics agent. A task of each is started on every instance, and an instance only
+   * counts as deployed once all of the group's tasks are running.
+   */
+  private List<String> additionalTaskDefinitions;
+
+  /**
+   * Arbitrary key/value pairs, matched by ListEnvironments label selectors. Labels must be
+   * selectable, see {@link LabelSelector#validateLabels(Map)}.
+   */
+  private Map<String, String> labels;
+
+  @Builder
+  private CreateEnvironmentRequest(
+      @NonNull final String name,
+      @NonNull final String taskDefinition,
+      @NonNull final String roleArn,
+      @NonNull final InstanceGroup instanceGroup,
+      final List<String> dependsOn,
+      final EnvironmentType environmentType,
+      final Integer desiredCount,
+      final LaunchType launchType,
+      final NetworkConfiguration networkConfiguration,
+      final String schedule,
+      final Boolean runOnAllInstances,
+      final List<EnvironmentVariable> environmentVariables,
+      final Boolean redeployOnReferenceChange,
+      final DeploymentStrategy deploymentStrategy,
+      final Duration maxTaskAge,
+      final List<String> antiAffinity,
+      final List<String> additionalTaskDefinitions,
+      final Map<String, String> labels)
+      throws InvalidParameterException {
+    LabelSelector.validateLabels(labels);
+
+    this.name = name;
+    this.taskDefinition = taskDefinition;
+    this.roleArn = roleArn;
+    this.instanceGroup = instanceGroup;
+    this.dependsOn = dependsOn;
+    this.environmentType = environmentType;
+    this.desiredCount = desiredCount;
+    this.launchType = launchType;
+    this.networkConfiguration = networkConfiguration;
+    this.schedule = schedule;
+    this.runOnAllInstances = runOnAllInstances;
+    this.environmentVariables = environmentVariables;
+    this.redeployOnReferenceChange = redeployOnReferenceChange;
+    this.deploymentStrategy = deploymentStrategy;
+    this.maxTaskAge = maxTaskAge;
+    this.antiAffinity = antiAffinity;
+    this.additionalTaskDefinitions = additionalTaskDefinitions;
+    this.labels = labels;
+  }
 }
@@ -14,6 +14,9 @@
  */
 package com.amazonaws.blox.dataservicemodel.v1.model;
 
+import java.time.Duration;
+import java.util.List;
+import java.util.Map;
 import lombok.Builder;
 import lombok.NonNull;
 import lombok.Value;
@@ -28,9 +31,49 @@ public class CreateEnvironmentResponse {
 
   @NonNull private String name;
 
+  /** The task definition reference as given, resolved separately by each deployment. */
   @NonNull private String taskDefinition;
 
   @NonNull private String roleArn;
 
   @NonNull private InstanceGroup instanceGroup;
+
+  /** Names of the environments this environment's deployments wait for. */
+  private List<String> dependsOn;
+
+  @NonNull private EnvironmentType environmentType;
+
+  /** Only set for REPLICA environments. */
+  private Integer desiredCount;
+
+  @NonNull private LaunchType launchType;
+
+  /** Only set for FARGATE environments. */
+  private NetworkConfiguration networkConfiguration;
+
+  /** Only set for SCHEDULED environments. */
+  private String schedule;
+
+  /** Only set for SCHEDULED environments. */
+  private Boolean runOnAllInstances;
+
+  /** The environment variables as given, with references left unresolved. */
+  private List<EnvironmentVariable> environmentVariables;
+
+  private boolean redeployOnReferenceChange;
+
+  @NonNull private DeploymentStrategy deploymentStrategy;
+
+  private Duration maxTaskAge;
+
+  /**
+   * Names of environments this environment declared anti-affinity with. Environments that declared
+   * anti-affinity with this one aren't listed, but are kept apart from it too.
+   */
+  private List<String> antiAffinity;
+
+  /** Task definition references deployed together with taskDefinition as one task group. */
+  private List<String> additionalTaskDefinitions;
+
+  private Map<String, String> labels;
 }
@@ -0,0 +1,34 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class CutoverDeploymentRequest {
+
+  @NonNull private final String environmentName;
+
+  @NonNull private final String deploymentId;
+
+  /** The ID of the environment's deployment lease, required while a lease is held. */
+  private final String leaseId;
+
+  /** The ARN of the caller, recorded on the transition that completes the deployment. */
+  @NonNull private final String initiatedBy;
+}
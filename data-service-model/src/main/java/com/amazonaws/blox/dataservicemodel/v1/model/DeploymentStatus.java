@@ -0,0 +1,77 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.util.Collections;
+import java.util.EnumSet;
+import java.util.Set;
+
+/**
+ * The lifecycle of a deployment.
+ *
+ * <p>Deployments are created as PENDING, and only move along the transitions returned by {@link
+ * #getNextStatuses()}. Writers must check {@link #canTransitionTo(DeploymentStatus)} against the
+ * status they read, and make the update conditional on that status being unchanged, so that
+ * concurrent updates can't move a deployment out of a terminal status.
+ */
+public enum DeploymentStatus {
+  PENDING,
+  IN_PROGRESS,
+  /**
+   * A canary deployment whose canary tasks are running, held until PromoteDeployment or
+   * RollbackDeployment. Deployment timeouts don't apply.
+   */
+  AWAITING_PROMOTION,
+  /**
+   * A BLUE_GREEN deployment whose new tasks are all running and healthy, held until
+   * CutoverDeployment or StopDeployment. Deployment timeouts don't apply.
+   */
+  AWAITING_CUTOVER,
+  STOPPING,
+  STOPPED,
+  CANCELED,
+  COMPLETED,
+  UNHEALTHY,
+  TIMED_OUT;
+
+  /** The statuses a deployment in this status may move to next. */
+  public Set<DeploymentStatus> getNextStatuses() {
+    switch (this) {
+      case PENDING:
+        return Collections.unmodifiableSet(EnumSet.of(IN_PROGRESS, CANCELED));
+      case IN_PROGRESS:
+        return Collections.unmodifiableSet(
+            EnumSet.of(
+                AWAITING_PROMOTION, AWAITING_CUTOVER, STOPPING, COMPLETED, UNHEALTHY, TIMED_OUT));
+      case AWAITING_PROMOTION:
+        return Collections.unmodifiableSet(EnumSet.of(IN_PROGRESS, STOPPING));
+      case AWAITING_CUTOVER:
+        return Collections.unmodifiableSet(EnumSet.of(COMPLETED, STOPPING));
+      case STOPPING:
+        return Collections.unmodifiableSet(EnumSet.of(STOPPED));
+      default:
+        return Collections.emptySet();
+    }
+  }
+
+  public boolean canTransitionTo(DeploymentStatus next) {
+    return getNextStatuses().contains(next);
+  }
+
+  /** Whether a deployment in this status will never change status again. */
+  public boolean isTerminal() {
+    return getNextStatuses().isEmpty();
+  }
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.time.Instant;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/**
+ * A single entry in a deployment's status history.
+ *
+ * <p>Only transitions allowed by {@link DeploymentStatus} can be constructed.
+ */
+@Value
+public class DeploymentStatusTransition {
+
+  /** The status before the transition, or null for the transition that created the deployment. */
+  private final DeploymentStatus from;
+
+  private final DeploymentStatus to;
+
+  private final Instant transitionedAt;
+
+  /**
+   * Who or what caused the transition: the caller's ARN for user requests, or the name of the Blox
+   * component (e.g. the scheduling manager) for automatic transitions.
+   */
+  private final String initiatedBy;
+
+  /** Why the transition happened, e.g. the reason given to StopDeployment. */
+  private final String reason;
+
+  @Builder
+  private DeploymentStatusTransition(
+      final DeploymentStatus from,
+      @NonNull final DeploymentStatus to,
+      @NonNull final Instant transitionedAt,
+      @NonNull final String initiatedBy,
+      final String reason) {
+    if (from == null ? to != DeploymentStatus.PENDING : !from.canTransitionTo(to)) {
+      throw new IllegalArgumentException(
+          "Illegal deployment status transition from " + from + " to " + to);
+    }
+
+    this.from = from;
+    this.to = to;
+    this.transitionedAt = transitionedAt;
+    this.initiatedBy = initiatedBy;
+    this.reason = reason;
+  }
+}
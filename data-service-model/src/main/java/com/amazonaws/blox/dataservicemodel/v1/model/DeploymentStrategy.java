@@ -0,0 +1,27 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+/** How a deployment replaces an environment's tasks. */
+public enum DeploymentStrategy {
+  /** Old tasks are replaced by new ones a batch of instances at a time. */
+  ROLLING,
+  /**
+   * New tasks are started alongside the old ones as the other color. Once they are healthy, the
+   * deployment waits for a CutoverDeployment call, which makes the new color live and stops the old
+   * tasks.
+   */
+  BLUE_GREEN
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.time.Instant;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class DeploymentSummary {
+
+  @NonNull private final String deploymentId;
+
+  @NonNull private final String environmentName;
+
+  @NonNull private final String environmentVersion;
+
+  @NonNull private final DeploymentStatus status;
+
+  @NonNull private final Instant createdAt;
+
+  /** The release notes given to the request that created the deployment. */
+  private final String description;
+
+  /** When the deployment moved to IN_PROGRESS. Not set while it is PENDING. */
+  private final Instant startTime;
+
+  /** When the deployment reached a terminal status. */
+  private final Instant endTime;
+
+  /**
+   * The transition into the current status. For a deployment that has ended, it records who or what
+   * ended it and why, e.g. the caller and reason given to StopDeployment.
+   */
+  @NonNull private final DeploymentStatusTransition statusTransition;
+}
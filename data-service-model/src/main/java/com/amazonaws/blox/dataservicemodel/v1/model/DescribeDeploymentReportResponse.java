@@ -0,0 +1,50 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.time.Duration;
+import java.time.Instant;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/** A summary of the deployments created in a time range, across all environments. */
+@Value
+@Builder
+public class DescribeDeploymentReportResponse {
+
+  @NonNull private final Instant startTime;
+
+  @NonNull private final Instant endTime;
+
+  private final String clusterArn;
+
+  private final int deploymentCount;
+
+  /** Deployments that reached COMPLETED. */
+  private final int completedCount;
+
+  /** Deployments that ended UNHEALTHY or TIMED_OUT. */
+  private final int failedCount;
+
+  /** Deployments created by RollbackDeployment. */
+  private final int rollbackCount;
+
+  /** completedCount / (completedCount + failedCount). Not set if no deployment has finished. */
+  private final Double successRate;
+
+  /** The mean time from start to end of the finished deployments. */
+  private final Duration meanDuration;
+}
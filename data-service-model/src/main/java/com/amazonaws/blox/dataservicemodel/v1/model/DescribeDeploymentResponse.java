@@ -0,0 +1,83 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.time.Instant;
+import java.util.List;
+import java.util.Map;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class DescribeDeploymentResponse {
+
+  @NonNull private final String deploymentId;
+
+  @NonNull private final String environmentName;
+
+  @NonNull private final String environmentVersion;
+
+  /** The environment's latest successful deployment when this one was created, if any. */
+  private final String previousDeploymentId;
+
+  /** For rollbacks, the earlier deployment of environmentVersion that this one restores. */
+  private final String sourceDeploymentId;
+
+  /** The task definition revision ARN the deployment uses. */
+  @NonNull private final String taskDefinition;
+
+  private final List<String> additionalTaskDefinitions;
+
+  /** The release notes given to the request that created the deployment. */
+  private final String description;
+
+  private final CanaryConfiguration canary;
+
+  @NonNull private final DeploymentStatus status;
+
+  @NonNull private final Instant createdAt;
+
+  /** When the deployment moved to IN_PROGRESS. Not set while it is PENDING. */
+  private final Instant startTime;
+
+  /** When the deployment reached a terminal status. */
+  private final Instant endTime;
+
+  /**
+   * Instances in the cluster that the deployment didn't start a task on, and why. Skipped instances
+   * aren't counted as failures when the deployment's health is assessed.
+   */
+  private final List<SkippedInstance> skippedInstances;
+
+  /**
+   * How many StartTask calls failed for each failure reason ECS returned, e.g. "RESOURCE:MEMORY" or
+   * "AGENT", across all of the deployment's instances.
+   */
+  private final Map<String, Integer> failureReasons;
+
+  /** Started and failed tasks by the availability zone of their instance, e.g. "us-east-1a". */
+  private final Map<String, PlacementCounts> placementsByAvailabilityZone;
+
+  /** Started and failed tasks by the EC2 instance type of their instance, e.g. "c4.large". */
+  private final Map<String, PlacementCounts> placementsByInstanceType;
+
+  /**
+   * Every status transition of the deployment, oldest first, starting with the one that created it
+   * as PENDING.
+   */
+  @NonNull private final List<DeploymentStatusTransition> statusHistory;
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.time.Instant;
+import java.util.List;
+import java.util.Map;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/** The deployments that were running in a time range, grouped by cluster. */
+@Value
+@Builder
+public class DescribeDeploymentTimelineResponse {
+
+  @NonNull private final Instant startTime;
+
+  @NonNull private final Instant endTime;
+
+  /**
+   * Deployments keyed by cluster ARN, each list sorted by start time. Deployments that haven't
+   * finished have no end time.
+   */
+  @NonNull private final Map<String, List<DeploymentSummary>> deploymentsByCluster;
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.time.Duration;
+import java.util.List;
+import java.util.Map;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class DescribeEnvironmentResponse {
+
+  @NonNull private String environmentVersion;
+
+  @NonNull private String id;
+
+  @NonNull private String name;
+
+  /** The task definition reference as given, resolved separately by each deployment. */
+  @NonNull private String taskDefinition;
+
+  @NonNull private String roleArn;
+
+  @NonNull private InstanceGroup instanceGroup;
+
+  @NonNull private EnvironmentStatus status;
+
+  /** Whether the environment is suspended. Suspending an environment doesn't change its status. */
+  private boolean suspended;
+
+  /** Names of the environments this environment's deployments wait for. */
+  private List<String> dependsOn;
+
+  @NonNull private EnvironmentType environmentType;
+
+  /** Only set for REPLICA environments. */
+  private Integer desiredCount;
+
+  @NonNull private LaunchType launchType;
+
+  /** Only set for FARGATE environments. */
+  private NetworkConfiguration networkConfiguration;
+
+  /** Only set for SCHEDULED environments. */
+  private String schedule;
+
+  /** Only set for SCHEDULED environments. */
+  private Boolean runOnAllInstances;
+
+  /** The environment variables as given, with references left unresolved. */
+  private List<EnvironmentVariable> environmentVariables;
+
+  private boolean redeployOnReferenceChange;
+
+  @NonNull private DeploymentStrategy deploymentStrategy;
+
+  /** The color whose tasks are live. Only set for BLUE_GREEN environments. */
+  private DeploymentColor liveColor;
+
+  private Duration maxTaskAge;
+
+  /**
+   * Names of environments this environment declared anti-affinity with. Environments that declared
+   * anti-affinity with this one aren't listed, but are kept apart from it too.
+   */
+  private List<String> antiAffinity;
+
+  /** Task definition references deployed together with taskDefinition as one task group. */
+  private List<String> additionalTaskDefinitions;
+
+  private Map<String, String> labels;
+
+  private List<EnvironmentCondition> conditions;
+
+  /** The environment's active deployment lease, if any. */
+  private DeploymentLease deploymentLease;
+
+  /**
+   * What one of the environment's tasks reserves, from the task definitions of its latest
+   * deployment (all of them, for a task group). DAEMON environments reserve this on every instance
+   * they run on.
+   */
+  private ResourceReservation taskReservation;
+
+  /** What all of the environment's running tasks reserve across the cluster. */
+  private ResourceReservation totalReservation;
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.time.Instant;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/**
+ * An observation about the state of an environment.
+ *
+ * <p>An environment has at most one condition of each type; a condition's lastTransitionTime only
+ * changes when its status does.
+ */
+@Value
+@Builder
+public class EnvironmentCondition {
+
+  @NonNull private EnvironmentConditionType type;
+
+  @NonNull private EnvironmentConditionStatus status;
+
+  /** A short, machine-readable CamelCase reason for the condition's last transition. */
+  private String reason;
+
+  /** A human-readable description of the condition's last transition. */
+  private String message;
+
+  @NonNull private Instant lastTransitionTime;
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+public enum EnvironmentConditionType {
+  /** All instances in the environment are running the environment's task. */
+  AVAILABLE,
+  /** A deployment is in progress in the environment. */
+  PROGRESSING,
+  /** Tasks in the environment are failing to start or stay running. */
+  DEGRADED,
+  /** A deployment has stopped making progress without completing. */
+  STALLED,
+  /** Tasks could not be started because an account or cluster limit was reached. */
+  QUOTA_EXCEEDED
+}
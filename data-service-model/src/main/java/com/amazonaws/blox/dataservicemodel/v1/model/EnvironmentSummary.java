@@ -0,0 +1,41 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.util.List;
+import java.util.Map;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class EnvironmentSummary {
+
+  @NonNull private final String name;
+
+  @NonNull private final String environmentVersion;
+
+  @NonNull private final EnvironmentStatus status;
+
+  /** Whether the environment is suspended. Suspending an environment doesn't change its status. */
+  private final boolean suspended;
+
+  @NonNull private final EnvironmentType environmentType;
+
+  private final Map<String, String> labels;
+
+  private final List<EnvironmentCondition> conditions;
+}
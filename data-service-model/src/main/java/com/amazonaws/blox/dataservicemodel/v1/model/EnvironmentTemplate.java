@@ -0,0 +1,143 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+import java.util.regex.Matcher;
+import java.util.regex.Pattern;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/**
+ * A named environment configuration that InstantiateEnvironmentTemplate stamps out environments
+ * from.
+ *
+ * <p>taskDefinition, roleArn, and the instance group's cluster ARN and attribute values may contain
+ * ${name} placeholders for any of the declared parameters, e.g. "arn:aws:ecs:${region}:...".
+ * Placeholders for parameters that aren't declared are rejected when the template is built.
+ */
+@Value
+public class EnvironmentTemplate {
+
+  private static final Pattern PLACEHOLDER = Pattern.compile("\\$\\{([^}]*)\\}");
+
+  @NonNull private final String templateName;
+
+  private final String description;
+
+  /** Names of the parameters that every instantiation must provide a value for. */
+  @NonNull private final Set<String> parameters;
+
+  @NonNull private final String taskDefinition;
+
+  @NonNull private final String roleArn;
+
+  @NonNull private final InstanceGroup instanceGroup;
+
+  private final DeploymentStrategy deploymentStrategy;
+
+  @Builder
+  private EnvironmentTemplate(
+      @NonNull final String templateName,
+      final String description,
+      @NonNull final Set<String> parameters,
+      @NonNull final String taskDefinition,
+      @NonNull final String roleArn,
+      @NonNull final InstanceGroup instanceGroup,
+      final DeploymentStrategy deploymentStrategy)
+      throws InvalidParameterException {
+    this.templateName = templateName;
+    this.description = description;
+    this.parameters = parameters;
+    this.taskDefinition = taskDefinition;
+    this.roleArn = roleArn;
+    this.instanceGroup = instanceGroup;
+    this.deploymentStrategy = deploymentStrategy;
+
+    for (String value : templatedValues()) {
+      Matcher matcher = PLACEHOLDER.matcher(value);
+      while (matcher.find()) {
+        checkDeclared(matcher.group(1));
+      }
+    }
+  }
+
+  /**
+   * Checks that arguments has a value for every declared parameter, and no values for parameters
+   * that aren't declared.
+   */
+  public void validateArguments(@NonNull final Map<String, String> arguments)
+      throws InvalidParameterException {
+    for (String name : parameters) {
+      if (arguments.get(name) == null) {
+        throw new InvalidParameterException("Missing value for template parameter: '" + name + "'");
+      }
+    }
+
+    for (String name : arguments.keySet()) {
+      checkDeclared(name);
+    }
+  }
+
+  /**
+   * Replaces every ${name} placeholder in value with the argument for that parameter. Placeholders
+   * for parameters that aren't declared, or that have no argument, are rejected.
+   */
+  public String substitute(
+      @NonNull final String value, @NonNull final Map<String, String> arguments)
+      throws InvalidParameterException {
+    Matcher matcher = PLACEHOLDER.matcher(value);
+    StringBuffer result = new StringBuffer();
+
+    while (matcher.find()) {
+      String name = matcher.group(1);
+      checkDeclared(name);
+
+      String argument = arguments.get(name);
+      if (argument == null) {
+        throw new InvalidParameterException("Missing value for template parameter: '" + name + "'");
+      }
+
+      matcher.appendReplacement(result, Matcher.quoteReplacement(argument));
+    }
+
+    matcher.appendTail(result);
+    return result.toString();
+  }
+
+  private List<String> templatedValues() {
+    List<String> values = new ArrayList<>();
+    values.add(taskDefinition);
+    values.add(roleArn);
+    values.add(instanceGroup.getClusterArn());
+    if (instanceGroup.getAttributes() != null) {
+      for (Attribute attribute : instanceGroup.getAttributes()) {
+        values.add(attribute.getValue());
+      }
+    }
+    return values;
+  }
+
+  private void checkDeclared(final String name) throws InvalidParameterException {
+    if (!parameters.contains(name)) {
+      throw new InvalidParameterException("Unknown template parameter: '" + name + "'");
+    }
+  }
+}
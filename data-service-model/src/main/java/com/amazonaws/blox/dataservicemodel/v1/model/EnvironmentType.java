@@ -0,0 +1,25 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+/** How the scheduler places an environment's tasks. */
+public enum EnvironmentType {
+  /** One task on every instance in the environment's instance group. */
+  DAEMON,
+  /** desiredCount tasks placed across the instances in the environment's instance group. */
+  REPLICA,
+  /** Tasks run to completion on the environment's schedule, each run recorded as a TaskRun. */
+  SCHEDULED
+}
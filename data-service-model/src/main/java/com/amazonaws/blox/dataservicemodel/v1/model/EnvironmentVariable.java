@@ -0,0 +1,64 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.util.Objects;
+import java.util.stream.Stream;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/**
+ * An environment variable set on every container of an environment's tasks. Exactly one of value,
+ * ssmParameter and secretId must be set.
+ *
+ * <p>Literal values are passed as StartTask environment overrides. SSM parameters and Secrets
+ * Manager secrets are added as container secrets (valueFrom) to the task definition revision each
+ * deployment registers, so ECS resolves them when the task starts and their values never appear in
+ * the task's overrides.
+ */
+@Value
+public class EnvironmentVariable {
+
+  private final String name;
+
+  /** A literal value, used as given. */
+  private final String value;
+
+  /** The name or ARN of an SSM parameter whose value is used. */
+  private final String ssmParameter;
+
+  /** The ID or ARN of a Secrets Manager secret whose value is used. */
+  private final String secretId;
+
+  @Builder
+  private EnvironmentVariable(
+      @NonNull final String name,
+      final String value,
+      final String ssmParameter,
+      final String secretId)
+      throws InvalidParameterException {
+    if (Stream.of(value, ssmParameter, secretId).filter(Objects::nonNull).count() != 1) {
+      throw new InvalidParameterException(
+          "Exactly one of value, ssmParameter and secretId must be set for '" + name + "'");
+    }
+
+    this.name = name;
+    this.value = value;
+    this.ssmParameter = ssmParameter;
+    this.secretId = secretId;
+  }
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import lombok.Builder;
+import lombok.Value;
+
+@Value
+@Builder
+public class FreezeDeploymentsRequest {
+
+  /** The cluster to freeze deployments in. If not set, deployments are frozen in all clusters. */
+  private final String clusterArn;
+
+  /**
+   * Whether the freeze also applies to monitor-created deployments, such as starting tasks on new
+   * instances. By default only user-created deployments are rejected.
+   */
+  private final boolean includeMonitorDeployments;
+
+  private final String reason;
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.util.Map;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class InstantiateEnvironmentTemplateRequest {
+
+  @NonNull private final String templateName;
+
+  /** The name of the environment to create. */
+  @NonNull private final String environmentName;
+
+  /**
+   * A value for each of the template's parameters, checked with
+   * EnvironmentTemplate.validateArguments. Unknown parameter names are rejected.
+   */
+  @NonNull private final Map<String, String> arguments;
+
+  /** Labels for the created environment, see CreateEnvironmentRequest. */
+  private final Map<String, String> labels;
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.util.Collections;
+import java.util.LinkedHashMap;
+import java.util.Map;
+import lombok.AccessLevel;
+import lombok.AllArgsConstructor;
+import lombok.NonNull;
+import lombok.Value;
+
+/**
+ * Selects environments whose labels contain all of the given key/value pairs.
+ *
+ * <p>The string form is a comma-separated list of key=value requirements, e.g.
+ * "team=payments,tier=prod". Selectors can only be created by {@link #parse(String)}.
+ */
+@Value
+@AllArgsConstructor(access = AccessLevel.PRIVATE)
+public class LabelSelector {
+
+  @NonNull private final Map<String, String> requirements;
+
+  public static LabelSelector parse(@NonNull final String selector)
+      throws InvalidParameterException {
+    Map<String, String> requirements = new LinkedHashMap<>();
+
+    for (String requirement : selector.split(",")) {
+      String[] parts = requirement.split("=", -1);
+      if (parts.length != 2 || parts[0].trim().isEmpty()) {
+        throw new InvalidParameterException("Invalid label requirement: '" + requirement + "'");
+      }
+
+      String key = parts[0].trim();
+      if (requirements.containsKey(key)) {
+        throw new InvalidParameterException("Duplicate label requirement for key: '" + key + "'");
+      }
+
+      requirements.put(key, parts[1].trim());
+    }
+
+    return new LabelSelector(Collections.unmodifiableMap(requirements));
+  }
+
+  /**
+   * Checks that every label can be matched by a selector: keys must not be empty, and neither keys
+   * nor values may contain ',' or '=', or start or end with whitespace.
+   */
+  public static void validateLabels(final Map<String, String> labels)
+      throws InvalidParameterException {
+    if (labels == null) {
+      return;
+    }
+
+    for (Map.Entry<String, String> label : labels.entrySet()) {
+      String key = label.getKey();
+      String value = label.getValue();
+      if (key.isEmpty() || !isSelectable(key) || !isSelectable(value)) {
+        throw new InvalidParameterException("Invalid label: '" + key + "=" + value + "'");
+      }
+    }
+  }
+
+  private static boolean isSelectable(final String s) {
+    return s != null && s.equals(s.trim()) && s.indexOf(',') < 0 && s.indexOf('=') < 0;
+  }
+
+  public boolean matches(final Map<String, String> labels) {
+    if (labels == null) {
+      return requirements.isEmpty();
+    }
+
+    for (Map.Entry<String, String> requirement : requirements.entrySet()) {
+      if (!requirement.getValue().equals(labels.get(requirement.getKey()))) {
+        return false;
+      }
+    }
+
+    return true;
+  }
+}
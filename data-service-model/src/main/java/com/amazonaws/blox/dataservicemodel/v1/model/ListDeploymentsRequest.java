@@ -0,0 +1,38 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.util.Set;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class ListDeploymentsRequest {
+
+  @NonNull private final String environmentName;
+
+  /**
+   * Only list deployments in one of these statuses, e.g. PENDING to inspect the environment's
+   * deployment queue. If not set, deployments in all statuses are listed.
+   */
+  private final Set<DeploymentStatus> statuses;
+
+  private final Integer maxResults;
+
+  /** The nextToken returned by a previous ListDeployments call, to fetch the next page. */
+  private final String nextToken;
+}
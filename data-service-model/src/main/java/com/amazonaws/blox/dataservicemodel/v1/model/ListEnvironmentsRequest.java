@@ -0,0 +1,37 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import lombok.Builder;
+import lombok.Value;
+
+@Value
+@Builder
+public class ListEnvironmentsRequest {
+
+  /**
+   * Only list environments whose labels match this selector, in the form parsed by {@link
+   * LabelSelector#parse(String)}. If not set, all environments are listed.
+   */
+  private final String labelSelector;
+
+  /** Only list environments of this type. If not set, environments of all types are listed. */
+  private final EnvironmentType environmentType;
+
+  private final Integer maxResults;
+
+  /** The nextToken returned by a previous ListEnvironments call, to fetch the next page. */
+  private final String nextToken;
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class RollbackDeploymentRequest {
+
+  @NonNull private final String environmentName;
+
+  /**
+   * The environment version to roll back to. If not set, the version deployed by the previous
+   * successful deployment is used. The version must have been deployed by an earlier successful
+   * deployment; versions that were never deployed are rejected with a DeploymentNotFoundException,
+   * and should be deployed with StartDeployment instead.
+   */
+  private final String environmentVersion;
+
+  /** The ID of the environment's deployment lease, required while a lease is held. */
+  private final String leaseId;
+
+  /** Free-text (markdown) release notes describing the rollback, stored with the deployment. */
+  private final String description;
+
+  /**
+   * The ARN of the caller, recorded on the status transitions of the rollback deployment, and of
+   * any deployments it stops or cancels.
+   */
+  @NonNull private final String initiatedBy;
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.util.List;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class RollbackDeploymentResponse {
+
+  @NonNull private final String deploymentId;
+
+  @NonNull private final String environmentName;
+
+  @NonNull private final String environmentVersion;
+
+  /**
+   * The task definition revision ARN deployed by the source deployment, which the rollback deploys
+   * again rather than resolving the environment's reference anew.
+   */
+  @NonNull private final String taskDefinition;
+
+  /** The revision ARNs the environment's additional task definitions resolved to, in order. */
+  private final List<String> additionalTaskDefinitions;
+
+  /**
+   * The earlier successful deployment of environmentVersion that this deployment restores. Always
+   * set, since rolling back to a version that was never deployed is rejected.
+   */
+  @NonNull private final String sourceDeploymentId;
+}
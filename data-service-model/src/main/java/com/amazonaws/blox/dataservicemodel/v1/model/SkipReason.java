@@ -0,0 +1,31 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+/** Why a deployment didn't start a task on an instance in the environment's cluster. */
+public enum SkipReason {
+  /** The instance doesn't match the environment's instance group attributes. */
+  SELECTOR_MISMATCH,
+  /** The instance is DRAINING in ECS. */
+  DRAINING,
+  /** The instance is cordoned with CordonInstance. */
+  CORDONED,
+  /** The instance runs a task of an environment this one has anti-affinity with. */
+  ANTI_AFFINITY,
+  /** A host port the task needs is already in use on the instance. */
+  PORT_CONFLICT,
+  /** The instance doesn't have enough CPU or memory left for the task. */
+  INSUFFICIENT_RESOURCES
+}
@@ -25,4 +25,22 @@ public class StartDeploymentRequest {
   @NonNull private final String environmentName;
 
   @NonNull private final String environmentVersion;
+
+  /** The ID of the environment's deployment lease, required while a lease is held. */
+  private final String leaseId;
+
+  /** Free-text (markdown) release notes describing what changed, stored with the deployment. */
+  private final String description;
+
+  /**
+   * If set, the deployment is only rolled out to a subset of the instances, and waits there for
+   * PromoteDeployment or RollbackDeployment.
+   */
+  private final CanaryConfiguration canary;
+
+  /**
+   * The ARN of the caller, recorded on the status transitions of the new deployment, and of any
+   * pending deployments it supersedes.
+   */
+  @NonNull private final String initiatedBy;
 }
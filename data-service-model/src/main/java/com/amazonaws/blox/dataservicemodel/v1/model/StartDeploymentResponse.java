@@ -14,6 +14,7 @@
  */
 package com.amazonaws.blox.dataservicemodel.v1.model;
 
+import java.util.List;
 import lombok.Builder;
 import lombok.NonNull;
 import lombok.Value;
@@ -27,4 +28,15 @@ public class StartDeploymentResponse {
   @NonNull private final String environmentName;
 
   @NonNull private final String environmentVersion;
+
+  /**
+   * The task definition revision ARN the environment's task definition reference resolved to. The
+   * deployment uses this revision even if the reference later resolves to a different one.
+   */
+  @NonNull private final String taskDefinition;
+
+  /** The revision ARNs the environment's additional task definitions resolved to, in order. */
+  private final List<String> additionalTaskDefinitions;
+
+  private final String description;
 }
@@ -0,0 +1,41 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+@Value
+@Builder
+public class StopDeploymentRequest {
+
+  @NonNull private final String environmentName;
+
+  @NonNull private final String deploymentId;
+
+  /**
+   * Whether tasks already started by the deployment should be stopped as well. By default they are
+   * left running and the deployment just stops starting new ones. A BLUE_GREEN deployment that
+   * hasn't been cut over always has its new tasks stopped, since they run alongside the live color.
+   */
+  private final boolean stopStartedTasks;
+
+  /** The ARN of the caller stopping the deployment. */
+  @NonNull private final String initiatedBy;
+
+  /** A free-text explanation, recorded on the deployment's final status transition. */
+  private final String reason;
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import java.time.Instant;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/**
+ * A single run of a task to completion: one scheduled run of a SCHEDULED environment, or a one-off
+ * run started with StartTaskRun.
+ */
+@Value
+@Builder
+public class TaskRun {
+
+  @NonNull private final String runId;
+
+  /** The environment the run belongs to. Not set for one-off runs. */
+  private final String environmentName;
+
+  @NonNull private final String taskDefinition;
+
+  @NonNull private final TaskRunStatus status;
+
+  /** The ARN of the started task. Not set until the task has been started. */
+  private final String taskArn;
+
+  /** The instance the task was started on. Not set until the task has been started. */
+  private final String containerInstanceArn;
+
+  @NonNull private final Instant createdAt;
+
+  private final Instant startedAt;
+
+  private final Instant stoppedAt;
+
+  /** The exit code of the task's essential container, once it has stopped. */
+  private final Integer exitCode;
+
+  /** Why the run failed, e.g. the StartTask failure reason or the task's stopped reason. */
+  private final String reason;
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.time.Duration;
+import java.util.List;
+import java.util.Map;
+import lombok.Builder;
+import lombok.NonNull;
+import lombok.Value;
+
+/**
+ * Creates a new version of an environment, and by default deploys it.
+ *
+ * <p>Every field except taskDefinition and initiatedBy (and the name of the environment to update)
+ * is optional. Optional fields that aren't set keep their current value.
+ */
+@Value
+public class UpdateEnvironmentRequest {
+
+  private String name;
+
+  /** A task definition reference, see CreateEnvironmentRequest. */
+  private String taskDefinition;
+
+  private String roleArn;
+
+  private InstanceGroup instanceGroup;
+
+  /** Replaces the environment's dependencies if set, see CreateEnvironmentRequest. */
+  private List<String> dependsOn;
+
+  /**
+   * The number of tasks to run in a REPLICA environment. Tasks are started or stopped to match the
+   * new count when the new version is deployed.
+   */
+  private Integer desiredCount;
+
+  /** Replaces the network configuration of a FARGATE environment if set. */
+  private NetworkConfiguration networkConfiguration;
+
+  /** Replaces the schedule of a SCHEDULED environment if set. */
+  private String schedule;
+
+  /** Replaces whether a SCHEDULED environment runs its task on every instance if set. */
+  private Boolean runOnAllInstances;
+
+  /** Replaces the environment's environment variables if set, see CreateEnvironmentRequest. */
+  private List<EnvironmentVariable> environmentVariables;
+
+  /** Replaces whether the environment is redeployed when a referenced value changes if set. */
+  private Boolean redeployOnReferenceChange;
+
+  /** Replaces the environment's deployment strategy if set. */
+  private DeploymentStrategy deploymentStrategy;
+
+  /** Replaces the environment's maximum task age if set. */
+  private Duration maxTaskAge;
+
+  /** Replaces the environment's anti-affinity if set, see CreateEnvironmentRequest. */
+  private List<String> antiAffinity;
+
+  /**
+   * Replaces the environment's additional task definitions if set, see CreateEnvironmentRequest.
+   */
+  private List<String> additionalTaskDefinitions;
+
+  /**
+   * Replaces the environment's labels if set. Labels must be selectable, see {@link
+   * LabelSelector#validateLabels(Map)}.
+   */
+  private Map<String, String> labels;
+
+  /**
+   * Whether to only create the new environment version without deploying it. By default, a
+   * deployment of the new version is started as if StartDeployment had been called with it.
+   */
+  private boolean skipDeployment;
+
+  /** The ID of the environment's deployment lease, required to start a deployment while held. */
+  private String leaseId;
+
+  /**
+   * Free-text (markdown) release notes describing what changed, stored with the deployment started
+   * by the update.
+   */
+  private String description;
+
+  /**
+   * The ARN of the caller, recorded on the status transitions of the deployment started by the
+   * update, and of any pending deployments it supersedes.
+   */
+  private String initiatedBy;
+
+  @Builder
+  private UpdateEnvironmentRequest(
+      @NonNull final String name,
+      @NonNull final String taskDefinition,
+      final String roleArn,
+      final InstanceGroup instanceGroup,
+      final List<String> dependsOn,
+      final Integer desiredCount,
+      final NetworkConfiguration networkConfiguration,
+      final String schedule,
+      final Boolean runOnAllInstances,
+      final List<EnvironmentVariable> environmentVariables,
+      final Boolean redeployOnReferenceChange,
+      final DeploymentStrategy deploymentStrategy,
+      final Duration maxTaskAge,
+      final List<String> antiAffinity,
+      final List<String> additionalTaskDefinitions,
+      final Map<String, String> labels,
+      final boolean skipDeployment,
+      final String leaseId,
+      final String description,
+      @NonNull final String initiatedBy)
+      throws InvalidParameterException {
+    LabelSelector.validateLabels(labels);
+
+    this.name = name;
+    this.taskDefinition = taskDefinition;
+    this.roleArn = roleArn;
+    this.instanceGroup = instanceGroup;
+    this.dependsOn = dependsOn;
+    this.desiredCount = desiredCount;
+    this.networkConfiguration = networkConfiguration;
+    this.schedule = schedule;
+    this.runOnAllInstances = runOnAllInstances;
+    this.environmentVariables = environmentVariables;
+    this.redeployOnReferenceChange = redeployOnReferenceChange;
+    this.deploymentStrategy = deploymentStrategy;
+    this.maxTaskAge = maxTaskAge;
+    this.antiAffinity = antiAffinity;
+    this.additionalTaskDefinitions = additionalTaskDefinitions;
+    this.labels = labels;
+    this.skipDeployment = skipDeployment;
+    this.leaseId = leaseId;
+    this.description = description;
+    this.initiatedBy = initiatedBy;
+  }
+}
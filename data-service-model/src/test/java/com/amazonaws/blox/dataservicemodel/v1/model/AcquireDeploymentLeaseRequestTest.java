@@ -0,0 +1,50 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import static org.junit.Assert.assertEquals;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.time.Duration;
+import org.junit.Test;
+
+public final class AcquireDeploymentLeaseRequestTest {
+
+  @Test
+  public final void acceptsMaximumDuration() throws InvalidParameterException {
+    AcquireDeploymentLeaseRequest request = lease(AcquireDeploymentLeaseRequest.MAX_DURATION);
+
+    assertEquals(AcquireDeploymentLeaseRequest.MAX_DURATION, request.getDuration());
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsDurationOverMaximum() throws InvalidParameterException {
+    lease(AcquireDeploymentLeaseRequest.MAX_DURATION.plusSeconds(1));
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsZeroDuration() throws InvalidParameterException {
+    lease(Duration.ZERO);
+  }
+
+  private AcquireDeploymentLeaseRequest lease(final Duration duration)
+      throws InvalidParameterException {
+    return AcquireDeploymentLeaseRequest.builder()
+        .environmentName("agent")
+        .holder("pipeline-execution-1")
+        .duration(duration)
+        .build();
+  }
+}
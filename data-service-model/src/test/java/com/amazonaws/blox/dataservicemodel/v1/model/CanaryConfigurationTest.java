@@ -0,0 +1,57 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import static org.junit.Assert.assertEquals;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.time.Duration;
+import org.junit.Test;
+
+public final class CanaryConfigurationTest {
+
+  @Test
+  public final void buildsCanaryWithPercentage() throws InvalidParameterException {
+    CanaryConfiguration canary =
+        CanaryConfiguration.builder()
+            .instancePercent(10)
+            .observationPeriod(Duration.ofMinutes(30))
+            .build();
+
+    assertEquals(Integer.valueOf(10), canary.getInstancePercent());
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsCountAndPercentageTogether() throws InvalidParameterException {
+    CanaryConfiguration.builder()
+        .instanceCount(1)
+        .instancePercent(10)
+        .observationPeriod(Duration.ofMinutes(30))
+        .build();
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsCanaryWithoutSize() throws InvalidParameterException {
+    CanaryConfiguration.builder().observationPeriod(Duration.ofMinutes(30)).build();
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsPercentageOfWholeEnvironment() throws InvalidParameterException {
+    CanaryConfiguration.builder()
+        .instancePercent(100)
+        .observationPeriod(Duration.ofMinutes(30))
+        .build();
+  }
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import static org.junit.Assert.assertEquals;
+import static org.junit.Assert.assertFalse;
+import static org.junit.Assert.assertTrue;
+
+import java.time.Instant;
+import java.util.EnumSet;
+import org.junit.Test;
+
+public final class DeploymentStatusTest {
+
+  @Test
+  public final void terminalStatusesCannotTransition() {
+    for (DeploymentStatus status : DeploymentStatus.values()) {
+      if (status.isTerminal()) {
+        for (DeploymentStatus next : DeploymentStatus.values()) {
+          assertFalse(status + " -> " + next, status.canTransitionTo(next));
+        }
+      }
+    }
+  }
+
+  @Test
+  public final void completedCannotMoveBackToInProgress() {
+    assertTrue(DeploymentStatus.COMPLETED.isTerminal());
+    assertFalse(DeploymentStatus.COMPLETED.canTransitionTo(DeploymentStatus.IN_PROGRESS));
+  }
+
+  @Test
+  public final void heldDeploymentsCanBeStoppedButDontTimeOut() {
+    for (DeploymentStatus held :
+        EnumSet.of(DeploymentStatus.AWAITING_PROMOTION, DeploymentStatus.AWAITING_CUTOVER)) {
+      assertTrue(held.canTransitionTo(DeploymentStatus.STOPPING));
+      assertFalse(held.canTransitionTo(DeploymentStatus.TIMED_OUT));
+    }
+
+    assertTrue(DeploymentStatus.AWAITING_PROMOTION.canTransitionTo(DeploymentStatus.IN_PROGRESS));
+    assertTrue(DeploymentStatus.AWAITING_CUTOVER.canTransitionTo(DeploymentStatus.COMPLETED));
+  }
+
+  @Test
+  public final void buildsAllowedTransition() {
+    DeploymentStatusTransition transition =
+        DeploymentStatusTransition.builder()
+            .from(DeploymentStatus.PENDING)
+            .to(DeploymentStatus.IN_PROGRESS)
+            .transitionedAt(Instant.EPOCH)
+            .initiatedBy("scheduling-manager")
+            .build();
+
+    assertEquals(DeploymentStatus.IN_PROGRESS, transition.getTo());
+  }
+
+  @Test
+  public final void deploymentsAreCreatedPending() {
+    DeploymentStatusTransition.builder()
+        .to(DeploymentStatus.PENDING)
+        .transitionedAt(Instant.EPOCH)
+        .initiatedBy("scheduling-manager")
+        .build();
+  }
+
+  @Test(expected = IllegalArgumentException.class)
+  public final void rejectsIllegalTransition() {
+    DeploymentStatusTransition.builder()
+        .from(DeploymentStatus.COMPLETED)
+        .to(DeploymentStatus.IN_PROGRESS)
+        .transitionedAt(Instant.EPOCH)
+        .initiatedBy("scheduling-manager")
+        .build();
+  }
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import static org.junit.Assert.assertEquals;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.util.Arrays;
+import java.util.Collections;
+import java.util.HashMap;
+import java.util.HashSet;
+import java.util.Map;
+import org.junit.Test;
+
+public final class EnvironmentTemplateTest {
+
+  @Test
+  public final void substitutesEveryPlaceholder() throws InvalidParameterException {
+    EnvironmentTemplate template = template("${cluster}");
+
+    assertEquals(
+        "arn:aws:iam::123456789012:role/log-shipper",
+        template.substitute(template.getRoleArn(), arguments()));
+    assertEquals(
+        "prod$1", template.substitute(template.getInstanceGroup().getClusterArn(), arguments()));
+  }
+
+  @Test
+  public final void leavesValuesWithoutPlaceholdersUnchanged() throws InvalidParameterException {
+    EnvironmentTemplate template = template("${cluster}");
+
+    assertEquals(
+        "log-shipper:latest",
+        template.substitute(template.getTaskDefinition(), Collections.emptyMap()));
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsPlaceholdersForUndeclaredParameters() throws InvalidParameterException {
+    template("${region}");
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsMissingArguments() throws InvalidParameterException {
+    EnvironmentTemplate template = template("${cluster}");
+
+    template.substitute(template.getRoleArn(), Collections.singletonMap("cluster", "prod"));
+  }
+
+  @Test
+  public final void acceptsArgumentsForExactlyTheDeclaredParameters()
+      throws InvalidParameterException {
+    template("${cluster}").validateArguments(arguments());
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void validateArgumentsRejectsMissingArguments() throws InvalidParameterException {
+    template("${cluster}").validateArguments(Collections.singletonMap("account", "123456789012"));
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void validateArgumentsRejectsUnknownArguments() throws InvalidParameterException {
+    Map<String, String> arguments = arguments();
+    arguments.put("region", "us-east-1");
+
+    template("${cluster}").validateArguments(arguments);
+  }
+
+  private static EnvironmentTemplate template(final String clusterArn)
+      throws InvalidParameterException {
+    return EnvironmentTemplate.builder()
+        .templateName("log-shipper")
+        .parameters(new HashSet<>(Arrays.asList("account", "cluster")))
+        .taskDefinition("log-shipper:latest")
+        .roleArn("arn:aws:iam::${account}:role/log-shipper")
+        .instanceGroup(InstanceGroup.builder().clusterArn(clusterArn).build())
+        .build();
+  }
+
+  private static Map<String, String> arguments() {
+    Map<String, String> arguments = new HashMap<>();
+    arguments.put("account", "123456789012");
+    arguments.put("cluster", "prod$1");
+    return arguments;
+  }
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import static org.junit.Assert.assertEquals;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import org.junit.Test;
+
+public final class EnvironmentVariableTest {
+
+  @Test
+  public final void keepsLiteralValuesThatLookLikeReferences() throws InvalidParameterException {
+    EnvironmentVariable variable =
+        EnvironmentVariable.builder().name("PREFIX").value("ssm:not-a-parameter").build();
+
+    assertEquals("ssm:not-a-parameter", variable.getValue());
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsVariableWithoutValue() throws InvalidParameterException {
+    EnvironmentVariable.builder().name("TOKEN").build();
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsVariableWithValueAndReference() throws InvalidParameterException {
+    EnvironmentVariable.builder().name("TOKEN").value("literal").secretId("prod/token").build();
+  }
+}
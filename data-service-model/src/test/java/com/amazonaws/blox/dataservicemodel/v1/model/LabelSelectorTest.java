@@ -0,0 +1,88 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.dataservicemodel.v1.model;
+
+import static org.junit.Assert.assertEquals;
+import static org.junit.Assert.assertFalse;
+import static org.junit.Assert.assertTrue;
+import static org.junit.Assert.fail;
+
+import com.amazonaws.blox.dataservicemodel.v1.exception.InvalidParameterException;
+import java.util.Collections;
+import java.util.HashMap;
+import java.util.Map;
+import org.junit.Test;
+
+public final class LabelSelectorTest {
+
+  @Test
+  public final void parsesCommaSeparatedRequirements() throws InvalidParameterException {
+    LabelSelector selector = LabelSelector.parse("team=payments, tier=prod");
+
+    Map<String, String> expected = new HashMap<>();
+    expected.put("team", "payments");
+    expected.put("tier", "prod");
+
+    assertEquals(expected, selector.getRequirements());
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsRequirementWithoutValue() throws InvalidParameterException {
+    LabelSelector.parse("team");
+  }
+
+  @Test(expected = InvalidParameterException.class)
+  public final void rejectsDuplicateKeys() throws InvalidParameterException {
+    LabelSelector.parse("team=a,team=b");
+  }
+
+  @Test
+  public final void rejectsLabelsThatCantBeSelected() throws InvalidParameterException {
+    LabelSelector.validateLabels(Collections.singletonMap("team", "payments"));
+
+    for (String key : new String[] {"", "team,tier", "team=", " team"}) {
+      try {
+        LabelSelector.validateLabels(Collections.singletonMap(key, "payments"));
+        fail("Expected key '" + key + "' to be rejected");
+      } catch (InvalidParameterException e) {
+        // expected
+      }
+    }
+
+    for (String value : new String[] {"a,b", "a=b", "payments "}) {
+      try {
+        LabelSelector.validateLabels(Collections.singletonMap("team", value));
+        fail("Expected value '" + value + "' to be rejected");
+      } catch (InvalidParameterException e) {
+        // expected
+      }
+    }
+  }
+
+  @Test
+  public final void matchesOnlyWhenAllRequirementsMatch() throws InvalidParameterException {
+    LabelSelector selector = LabelSelector.parse("team=payments,tier=prod");
+
+    Map<String, String> labels = new HashMap<>();
+    labels.put("team", "payments");
+    labels.put("tier", "prod");
+    labels.put("owner", "alice");
+    assertTrue(selector.matches(labels));
+
+    labels.put("tier", "staging");
+    assertFalse(selector.matches(labels));
+    assertFalse(selector.matches(null));
+  }
+}
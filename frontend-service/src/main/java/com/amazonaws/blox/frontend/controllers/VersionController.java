@@ -0,0 +1,56 @@
+/*
+ * Copyright 2017 Amazon.com, Inc. or its affiliates. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"). You may
+ * not use this file except in compliance with the License. A copy of the
+ * License is located at
+ *
+ *     http://aws.amazon.com/apache2.0/
+ *
+ * or in the "LICENSE" file accompanying this file. This file is distributed
+ * on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+ * express or implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+package com.amazonaws.blox.frontend.controllers;
+
+import com.amazonaws.blox.frontend.models.Version;
+import io.swagger.annotations.Api;
+import io.swagger.annotations.ApiOperation;
+import java.io.IOException;
+import java.io.InputStream;
+import java.util.Collections;
+import java.util.Properties;
+import org.springframework.web.bind.annotation.RequestMapping;
+import org.springframework.web.bind.annotation.RequestMethod;
+import org.springframework.web.bind.annotation.RestController;
+
+@Api
+@RestController
+@RequestMapping(path = "/version", produces = "application/json")
+public class VersionController {
+  /** Generated at build time from the project version and git commit */
+  private static final String VERSION_RESOURCE = "/version.properties";
+
+  private final Version version = loadVersion();
+
+  @RequestMapping(method = RequestMethod.GET, consumes = "*/*")
+  @ApiOperation(value = "Describe the version of the Blox frontend")
+  public Version describeVersion() {
+    return version;
+  }
+
+  private static Version loadVersion() {
+    Properties properties = new Properties();
+    try (InputStream stream = VersionController.class.getResourceAsStream(VERSION_RESOURCE)) {
+      properties.load(stream);
+    } catch (IOException e) {
+      throw new RuntimeException(e);
+    }
+
+    return new Version(
+        properties.getProperty("version"),
+        properties.getProperty("gitSha"),
+        Collections.singletonList(properties.getProperty("apiVersion")));
+  }
+}
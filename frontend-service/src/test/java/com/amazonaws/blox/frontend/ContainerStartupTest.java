@@ -15,6 +15,7 @@
 package com.amazonaws.blox.frontend;
 
 import static org.junit.Assert.assertEquals;
+import static org.junit.Assert.assertTrue;
 
 import com.amazonaws.serverless.proxy.internal.model.AwsProxyResponse;
 import com.amazonaws.serverless.proxy.internal.testutils.AwsProxyRequestBuilder;
@@ -34,4 +35,15 @@ public final class ContainerStartupTest {
     assertEquals(200, response.getStatusCode());
     assertEquals("{\"name\":\"test-env\"}", response.getBody());
   }
+
+  @Test
+  public final void handleVersionRequestSuccessfully() {
+    AwsProxyResponse response =
+        handler.handleRequest(
+            new AwsProxyRequestBuilder().method("GET").path("/version").build(),
+            new MockLambdaContext());
+
+    assertEquals(200, response.getStatusCode());
+    assertTrue(response.getBody().contains("\"apiVersions\":[\"v2017-07-11\"]"));
+  }
 }